@@ -0,0 +1,106 @@
+package textwrap
+
+import "strings"
+
+// Alignment governs how Fill pads or justifies the lines Wrap
+// produces to fill Width.
+type Alignment int
+
+const (
+	// AlignLeft leaves each line as Wrap produced it.  This is the
+	// default.
+	AlignLeft Alignment = iota
+	// AlignRight pads each line on the left with spaces so it ends
+	// at Width.
+	AlignRight
+	// AlignCenter pads each line on both sides so it is centered
+	// within Width.
+	AlignCenter
+	// AlignJustify distributes extra spaces between the words of
+	// each line so it reaches exactly Width.  The last line of a
+	// paragraph, and any line with no more than one word, are left
+	// alone.
+	AlignJustify
+)
+
+// joinAligned joins lines the way Fill does, applying align to each
+// line first.  If align is AlignLeft, this is exactly strings.Join.
+// splits is the parallel slice wrap returns alongside lines, reporting
+// which lines contain a break-long-word split.
+func (t *TextWrapper) joinAligned(lines []string, splits []bool, align Alignment) string {
+	if align != AlignLeft {
+		for i := range lines {
+			lines[i] = t.alignLine(lines, splits, i, align)
+		}
+	}
+
+	return strings.Join(lines, t.Newline)
+}
+
+// alignLine applies align to lines[i].  It skips blank lines and
+// lines ending in the placeholder, leaving those lines exactly as
+// Wrap produced them.  For AlignJustify, it additionally skips the
+// last line of a paragraph, a line with fewer than two words, and any
+// line splits marks as containing a break-long-word split--the
+// fragments a split leaves behind aren't real words, so stretching
+// space around them would misrepresent the text.
+func (t *TextWrapper) alignLine(lines []string, splits []bool, i int, align Alignment) string {
+	line := lines[i]
+	placeholder := t.lStrip(t.Placeholder)
+	if line == "" || (placeholder != "" && strings.HasSuffix(line, placeholder)) {
+		return line
+	}
+
+	pad := t.Width - t.lineWidth(line)
+	if pad <= 0 {
+		return line
+	}
+
+	switch align {
+	case AlignRight:
+		return strings.Repeat(t.Space, pad) + line
+
+	case AlignCenter:
+		left := pad / 2
+		return strings.Repeat(t.Space, left) + line +
+			strings.Repeat(t.Space, pad-left)
+
+	case AlignJustify:
+		endOfParagraph := i == len(lines)-1 || lines[i+1] == ""
+		if endOfParagraph || (i < len(splits) && splits[i]) {
+			return line
+		}
+		indent := t.LeadWhitespaceRe.FindString(line)
+		words := strings.Split(line[len(indent):], t.Space)
+		if len(words) < 2 {
+			return line
+		}
+		return indent + justifyWords(words, pad, t.Space)
+	}
+
+	return line
+}
+
+// justifyWords distributes pad extra spaces as evenly as possible
+// across the gaps between words, favoring earlier gaps when pad
+// doesn't divide evenly.
+func justifyWords(words []string, pad int, space string) string {
+	gaps := len(words) - 1
+	base, extra := pad/gaps, pad%gaps
+
+	var b strings.Builder
+	for i, word := range words {
+		b.WriteString(word)
+		if i == gaps {
+			break
+		}
+
+		n := 1 + base
+		if i < extra {
+			n++
+		}
+		b.WriteString(strings.Repeat(space, n))
+	}
+
+	return b.String()
+}
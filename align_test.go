@@ -0,0 +1,68 @@
+package textwrap
+
+import "testing"
+
+func TestAlign(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		opts []option
+		want string
+	}{
+		{
+			name: "AlignRight pads to Width",
+			text: "one two",
+			opts: []option{Width(10), Align(AlignRight)},
+			want: "   one two",
+		},
+		{
+			name: "AlignCenter pads both sides",
+			text: "one two",
+			opts: []option{Width(11), Align(AlignCenter)},
+			want: "  one two  ",
+		},
+		{
+			name: "AlignJustify distributes gaps, leaving the last line and single-word lines alone",
+			text: "one two three four five six seven eight nine ten",
+			opts: []option{Width(10), Align(AlignJustify)},
+			want: "one    two\nthree four\nfive   six\nseven\neight nine\nten",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Fill(c.text, c.opts...)
+			if got != c.want {
+				t.Errorf("Fill(%q) = %q, want %q", c.text, got, c.want)
+			}
+		})
+	}
+}
+
+// TestAlignANSIWidth is a regression test for alignLine measuring the
+// width of an already-joined line including its raw ANSI escape bytes,
+// which made colored lines look too wide and never get padded.
+func TestAlignANSIWidth(t *testing.T) {
+	text := "\x1b[31mred\x1b[0m text here"
+	got := Fill(text, Width(20), HandleANSI(true), Align(AlignRight))
+	want := "       \x1b[31mred\x1b[0m text here"
+
+	if got != want {
+		t.Errorf("Fill(%q) = %q, want %q", text, got, want)
+	}
+}
+
+// TestAlignJustifySkipsBreakLongWordSplit is a regression test for
+// AlignJustify stretching spaces around the fragments a BreakLongWords
+// split leaves behind--the line ending in the first half of the split
+// word, and the line starting with its second half--since neither
+// fragment is a real word.
+func TestAlignJustifySkipsBreakLongWordSplit(t *testing.T) {
+	text := "a bb reallylongwordxyz cc dd ee ff"
+	got := Fill(text, Width(9), Align(AlignJustify))
+	want := "a bb real\nlylongwor\ndxyz cc\ndd ee ff"
+
+	if got != want {
+		t.Errorf("Fill(%q) = %q, want %q", text, got, want)
+	}
+}
@@ -0,0 +1,98 @@
+package textwrap
+
+import "strings"
+
+// ansiEscapePattern matches a single ANSI CSI sequence (ESC '['
+// parameter/intermediate bytes followed by a final byte in the
+// 0x40-0x7E range) or OSC sequence (ESC ']' ... terminated by BEL or
+// ST).  It is used both to tokenize escape sequences as unbreakable
+// chunks and to recognize them as zero-width.
+const ansiEscapePattern = "\x1b\\[[0-9:;<=>?]*[ -/]*[@-~]|\x1b\\].*?(?:\x07|\x1b\\\\)"
+
+const ansiReset = "\x1b[0m"
+
+// chunkWidth measures the display width of a single chunk produced
+// by Wrap's tokenizer.  ANSI escape sequences are zero-width when
+// HandleANSI is true; everything else is measured by WidthFunc.
+func (t *TextWrapper) chunkWidth(s string) int {
+	if t.HandleANSI && t.AnsiEscapeRe.FindString(s) == s {
+		return 0
+	}
+
+	return t.WidthFunc(s)
+}
+
+// lineWidth measures the display width of an already-joined line.
+// When HandleANSI is true, ANSI escape sequences are stripped first so
+// they don't count toward the width, matching how chunkWidth treats
+// them while the line is still a slice of chunks.
+func (t *TextWrapper) lineWidth(s string) int {
+	if t.HandleANSI {
+		s = t.AnsiEscapeRe.ReplaceAllString(s, "")
+	}
+
+	return t.WidthFunc(s)
+}
+
+// ansiSGRCodes extracts the semicolon-separated parameters of an SGR
+// ("m"-terminated CSI) escape sequence.  It returns nil if seq is not
+// an SGR sequence, and a single "0" code for a bare reset such as
+// "\x1b[m".
+func ansiSGRCodes(seq string) []string {
+	if !strings.HasSuffix(seq, "m") || !strings.HasPrefix(seq, "\x1b[") {
+		return nil
+	}
+
+	params := seq[2 : len(seq)-1]
+	if params == "" {
+		return []string{"0"}
+	}
+
+	return strings.Split(params, ";")
+}
+
+// updateANSIState applies the SGR codes carried by chunk to state,
+// which tracks the currently active attributes across a Wrap call.
+// A reset code (0, or an empty code) clears state; any other code is
+// appended, reflecting the last-applied attribute of each kind seen
+// so far.
+func updateANSIState(state []string, chunk string) []string {
+	for _, code := range ansiSGRCodes(chunk) {
+		if code == "" || code == "0" {
+			state = state[:0]
+			continue
+		}
+		state = append(state, code)
+	}
+
+	return state
+}
+
+// ansiSGR builds the escape sequence that re-applies the attributes
+// recorded in state, or "" if state is empty.
+func ansiSGR(state []string) string {
+	if len(state) == 0 {
+		return ""
+	}
+
+	return "\x1b[" + strings.Join(state, ";") + "m"
+}
+
+// ansiLine joins curLine into a single string, re-applying startState
+// (the attributes active when the line began) at the start of the
+// line and, if endState is still active once the line ends, emitting
+// a reset so the broken styling doesn't bleed into whatever follows.
+// If HandleANSI is false, it behaves exactly like strings.Join.
+func (t *TextWrapper) ansiLine(startState, endState, curLine []string) string {
+	line := strings.Join(curLine, "")
+	if !t.HandleANSI {
+		return line
+	}
+
+	line = ansiSGR(startState) + line
+	if len(endState) > 0 {
+		line += ansiReset
+	}
+
+	return line
+}
@@ -0,0 +1,90 @@
+package textwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunkWidth(t *testing.T) {
+	tw := NewTextWrapper()
+	tw.HandleANSI = true
+
+	cases := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"plain text", "hello", 5},
+		{"SGR escape is zero width", "\x1b[31m", 0},
+		{"reset escape is zero width", "\x1b[0m", 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := tw.chunkWidth(c.s); got != c.want {
+				t.Errorf("chunkWidth(%q) = %d, want %d", c.s, got, c.want)
+			}
+		})
+	}
+}
+
+func TestAnsiSGRCodes(t *testing.T) {
+	cases := []struct {
+		name string
+		seq  string
+		want []string
+	}{
+		{"not an escape sequence", "hello", nil},
+		{"single code", "\x1b[31m", []string{"31"}},
+		{"multiple codes", "\x1b[1;31m", []string{"1", "31"}},
+		{"bare reset", "\x1b[m", []string{"0"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ansiSGRCodes(c.seq)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("ansiSGRCodes(%q) = %#v, want %#v", c.seq, got, c.want)
+			}
+		})
+	}
+}
+
+func TestUpdateANSIState(t *testing.T) {
+	state := updateANSIState(nil, "\x1b[31m")
+	state = updateANSIState(state, "\x1b[1m")
+	if want := []string{"31", "1"}; !reflect.DeepEqual(state, want) {
+		t.Fatalf("state after applying codes = %#v, want %#v", state, want)
+	}
+
+	state = updateANSIState(state, "\x1b[0m")
+	if len(state) != 0 {
+		t.Fatalf("state after reset = %#v, want empty", state)
+	}
+}
+
+func TestAnsiLine(t *testing.T) {
+	tw := NewTextWrapper()
+	tw.HandleANSI = true
+
+	got := tw.ansiLine([]string{"31"}, []string{"31"}, []string{"red"})
+	want := "\x1b[31mred" + ansiReset
+	if got != want {
+		t.Errorf("ansiLine = %q, want %q", got, want)
+	}
+
+	got = tw.ansiLine(nil, nil, []string{"plain"})
+	if got != "plain" {
+		t.Errorf("ansiLine with no active state = %q, want %q", got, "plain")
+	}
+}
+
+func TestWrapHandleANSI(t *testing.T) {
+	text := "\x1b[31mred\x1b[0m green blue"
+	got := Wrap(text, Width(8), HandleANSI(true))
+	want := []string{"\x1b[31mred\x1b[0m", "green", "blue"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrap(%q) = %q, want %q", text, got, want)
+	}
+}
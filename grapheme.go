@@ -0,0 +1,94 @@
+package textwrap
+
+import "strings"
+
+// regionalIndicatorStart and regionalIndicatorEnd bound the Unicode
+// Regional Indicator Symbol block, pairs of which combine into a
+// single flag emoji cluster (e.g. U+1F1FA U+1F1F8 is the US flag).
+const (
+	regionalIndicatorStart = 0x1F1E6
+	regionalIndicatorEnd   = 0x1F1FF
+)
+
+const zeroWidthJoiner = '\u200d'
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorStart && r <= regionalIndicatorEnd
+}
+
+// graphemeClusters splits s into extended grapheme clusters using a
+// simplified approximation of UAX #29: each cluster is a base rune,
+// plus any combining marks or variation selectors that follow it
+// (per isZeroWidth), plus any run of zero-width-joined runes and
+// their own combining marks, with a pair of Regional Indicator
+// Symbols grouped into a single flag cluster. It does not implement
+// the full Unicode segmentation algorithm--Hangul conjoining jamo
+// and prepended/SpacingMark scripts aren't special-cased--but it
+// covers the cases that matter for not corrupting a cluster when
+// BreakLongWords splits a chunk: combining marks, ZWJ sequences, and
+// flag pairs.
+func graphemeClusters(s string) []string {
+	runes := []rune(s)
+	var clusters []string
+
+	for i := 0; i < len(runes); {
+		start := i
+		i++
+
+		if isRegionalIndicator(runes[start]) && i < len(runes) &&
+			isRegionalIndicator(runes[i]) {
+			i++
+		}
+
+		for i < len(runes) {
+			if isZeroWidth(runes[i]) {
+				i++
+				continue
+			}
+			if runes[i-1] == zeroWidthJoiner {
+				i++
+				continue
+			}
+			break
+		}
+
+		clusters = append(clusters, string(runes[start:i]))
+	}
+
+	return clusters
+}
+
+// splitGraphemesByWidth is splitByWidth's grapheme-cluster
+// counterpart: it splits clusters at the last cluster boundary whose
+// inclusion keeps the measured width of the prefix within width. At
+// least one cluster is always placed in the prefix, even if it alone
+// exceeds width, so that a split always makes progress.
+func splitGraphemesByWidth(clusters []string, width int, widthFunc func(string) int) ([]string, []string) {
+	for n := 1; n <= len(clusters); n++ {
+		if widthFunc(strings.Join(clusters[:n], "")) > width {
+			if n == 1 {
+				return clusters[:1], clusters[1:]
+			}
+			return clusters[:n-1], clusters[n-1:]
+		}
+	}
+
+	return clusters, nil
+}
+
+// splitChunk splits c into a head that fits within width (as
+// measured by t.WidthFunc) and the remaining tail, the way Wrap
+// breaks a chunk too long to fit on its own line. If t.GraphemeAware
+// is true, it cuts only at extended grapheme cluster boundaries, so
+// a base letter is never separated from its combining marks, a ZWJ
+// emoji sequence is never sliced mid-cluster, and a flag pair is
+// never split; otherwise it cuts at rune boundaries.
+func (t *TextWrapper) splitChunk(c string, width int) (string, string) {
+	if !t.GraphemeAware {
+		head, tail := splitByWidth([]rune(c), width, t.WidthFunc)
+		return string(head), string(tail)
+	}
+
+	head, tail := splitGraphemesByWidth(graphemeClusters(c), width, t.WidthFunc)
+	return strings.Join(head, ""), strings.Join(tail, "")
+}
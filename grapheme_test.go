@@ -0,0 +1,85 @@
+package textwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemeClusters(t *testing.T) {
+	// eAcute is "e" followed by a combining acute accent (U+0301),
+	// the decomposed form of U+00E9 (e with acute).
+	eAcute := "é"
+	// family is three person emoji joined by ZWJ (U+200D) into a
+	// single family cluster.
+	family := "\U0001F468‍\U0001F469‍\U0001F467"
+	// usFlag and frFlag are each a pair of Regional Indicator
+	// Symbols that combine into a single flag cluster.
+	usFlag := "\U0001F1FA\U0001F1F8"
+	frFlag := "\U0001F1EB\U0001F1F7"
+
+	cases := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{
+			name: "plain ascii",
+			in:   "abc",
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "base letter with combining mark",
+			in:   eAcute + "cole",
+			want: []string{eAcute, "c", "o", "l", "e"},
+		},
+		{
+			name: "ZWJ family emoji stays one cluster",
+			in:   family,
+			want: []string{family},
+		},
+		{
+			name: "regional indicator flag pair stays one cluster",
+			in:   usFlag,
+			want: []string{usFlag},
+		},
+		{
+			name: "two flags stay separate clusters",
+			in:   usFlag + frFlag,
+			want: []string{usFlag, frFlag},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := graphemeClusters(c.in)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("graphemeClusters(%q) = %q, want %q", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestSplitChunkGraphemeAware(t *testing.T) {
+	family := "\U0001F468‍\U0001F469‍\U0001F467"
+
+	tw := NewTextWrapper()
+	head, tail := tw.splitChunk("ab"+family+"cd", 7)
+
+	if head != "ab"+family {
+		t.Errorf("head = %q, want %q", head, "ab"+family)
+	}
+	if tail != "cd" {
+		t.Errorf("tail = %q, want %q", tail, "cd")
+	}
+}
+
+func TestSplitChunkGraphemeUnaware(t *testing.T) {
+	family := "\U0001F468‍\U0001F469‍\U0001F467"
+
+	tw := NewTextWrapper(GraphemeAware(false))
+	head, _ := tw.splitChunk(family, 1)
+
+	if head == family {
+		t.Errorf("head = %q, want the cluster split mid-sequence", head)
+	}
+}
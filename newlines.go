@@ -0,0 +1,56 @@
+package textwrap
+
+import "strings"
+
+// wrapParagraphs implements Wrap when PreserveNewlines is true.  It
+// splits text on newlines and wraps each paragraph independently,
+// preserving blank lines as blank lines in the output instead of
+// letting Wrap's regular tokenization collapse them into whichever
+// paragraph follows.  Because each paragraph is wrapped from scratch,
+// InitialIndent is applied to the first line of every paragraph, not
+// only the first line of the whole text.
+//
+// If MaxLines is positive, it bounds the total number of lines
+// wrapParagraphs returns across every paragraph, not each paragraph
+// individually: once that many lines have been emitted, any
+// remaining paragraphs (including blank separator lines) are
+// dropped, and the paragraph that reaches the limit gets whatever
+// budget remains as its own MaxLines, so it ends with Placeholder
+// exactly as Wrap's non-paragraph path would.
+//
+// Alongside the wrapped lines, it returns a parallel slice reporting
+// whether each line contains a break-long-word split; blank separator
+// lines always report false.
+func (t *TextWrapper) wrapParagraphs(text string) ([]string, []bool) {
+	paragraphs := strings.Split(text, t.Newline)
+
+	var lines []string
+	var splits []bool
+	for _, para := range paragraphs {
+		if t.MaxLines > 0 && len(lines) >= t.MaxLines {
+			break
+		}
+
+		if t.strip(para) == "" {
+			lines = append(lines, "")
+			splits = append(splits, false)
+			continue
+		}
+
+		sub := *t
+		sub.PreserveNewlines = false
+		if t.PreserveIndentation {
+			sub.SubsequentIndent = t.LeadWhitespaceRe.FindString(para) +
+				t.SubsequentIndent
+		}
+		if t.MaxLines > 0 {
+			sub.MaxLines = t.MaxLines - len(lines)
+		}
+
+		paraLines, paraSplits := sub.wrap(para)
+		lines = append(lines, paraLines...)
+		splits = append(splits, paraSplits...)
+	}
+
+	return lines, splits
+}
@@ -0,0 +1,74 @@
+package textwrap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrapPreserveNewlines(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		opts []option
+		want []string
+	}{
+		{
+			name: "blank lines are preserved between paragraphs",
+			text: "one two three\n\nfour five six",
+			opts: []option{Width(80), PreserveNewlines(true)},
+			want: []string{"one two three", "", "four five six"},
+		},
+		{
+			name: "each paragraph wraps independently",
+			text: "one two three four\n\nfive six seven eight",
+			opts: []option{Width(10), PreserveNewlines(true)},
+			want: []string{"one two", "three four", "", "five six", "seven", "eight"},
+		},
+		{
+			name: "InitialIndent is applied to every paragraph's first line",
+			text: "one two\n\nthree four",
+			opts: []option{Width(80), PreserveNewlines(true), InitialIndent("> ")},
+			want: []string{"> one two", "", "> three four"},
+		},
+		{
+			name: "PreserveIndentation carries each paragraph's own leading whitespace",
+			text: "one two three four\n    five six seven eight",
+			opts: []option{Width(10), PreserveNewlines(true), PreserveIndentation(true)},
+			want: []string{"one two", "three four", "    five", "    six", "    seven", "    eight"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Wrap(c.text, c.opts...)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Wrap(%q) = %q, want %q", c.text, got, c.want)
+			}
+		})
+	}
+}
+
+// TestWrapPreserveNewlinesMaxLines ensures MaxLines bounds the total
+// number of lines wrapParagraphs returns across the whole text, not
+// each paragraph independently.
+func TestWrapPreserveNewlinesMaxLines(t *testing.T) {
+	text := "one two three four five\n\nsix seven eight nine ten"
+
+	got := Wrap(text, Width(5), PreserveNewlines(true), MaxLines(1))
+	want := []string{"o [...]"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrap(%q) = %q, want %q", text, got, want)
+	}
+}
+
+func TestWrapPreserveNewlinesMaxLinesAcrossParagraphs(t *testing.T) {
+	text := "one two\n\nthree four\n\nfive six"
+
+	got := Wrap(text, Width(80), PreserveNewlines(true), MaxLines(2))
+	want := []string{"one two", ""}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrap(%q) = %q, want %q", text, got, want)
+	}
+}
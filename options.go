@@ -113,3 +113,68 @@ func Placeholder(s string) option {
         t.Placeholder = s
     }
 }
+
+// A call to WidthMeasure may be passed to NewTextWrapper or any
+// wrapping function to override the default width function, which
+// counts one column per rune.  Pass StringWidth to measure East-Asian
+// wide characters and combining marks correctly, or a custom func for
+// other notions of display width.
+func WidthMeasure(f func(string) int) option {
+    return func(t *TextWrapper) {
+        t.WidthFunc = f
+    }
+}
+
+// A call to HandleANSI may be passed to NewTextWrapper or any
+// wrapping function to override the default value (false).  When
+// true, ANSI SGR escape sequences are treated as zero-width,
+// unbreakable atoms, and colors/attributes are carried across wrap
+// points.
+func HandleANSI(b bool) option {
+    return func(t *TextWrapper) {
+        t.HandleANSI = b
+    }
+}
+
+// A call to PreserveNewlines may be passed to NewTextWrapper or any
+// wrapping function to override the default value (false).  When
+// true, Wrap splits text on newlines and wraps each paragraph
+// independently, preserving blank lines instead of collapsing them.
+func PreserveNewlines(b bool) option {
+    return func(t *TextWrapper) {
+        t.PreserveNewlines = b
+    }
+}
+
+// A call to PreserveIndentation may be passed to NewTextWrapper or
+// any wrapping function to override the default value (false).  It
+// only has an effect if PreserveNewlines is also true.  When true,
+// the leading whitespace of each input line becomes an implicit
+// SubsequentIndent for continuation lines produced by wrapping that
+// paragraph.
+func PreserveIndentation(b bool) option {
+    return func(t *TextWrapper) {
+        t.PreserveIndentation = b
+    }
+}
+
+// A call to Align may be passed to NewTextWrapper or any wrapping
+// function to override the default alignment (AlignLeft).  It is
+// consumed by Fill; pass FillAligned an Alignment directly to
+// override it for a single call.
+func Align(a Alignment) option {
+    return func(t *TextWrapper) {
+        t.Alignment = a
+    }
+}
+
+// A call to GraphemeAware may be passed to NewTextWrapper or any
+// wrapping function to override the default value (true).  Set it
+// to false to split long chunks at rune boundaries instead of
+// grapheme cluster boundaries, skipping the cluster scan for text
+// known to be pure ASCII.
+func GraphemeAware(b bool) option {
+    return func(t *TextWrapper) {
+        t.GraphemeAware = b
+    }
+}
@@ -81,6 +81,50 @@ type TextWrapper struct {
 	// value is " [...]".
 	Placeholder string
 
+	// If true, Wrap treats ANSI SGR escape sequences (e.g. "\x1b[31m")
+	// as zero-width, unbreakable atoms, and carries the active color
+	// and attribute state across wrap points: a broken line ends with
+	// a reset and the following line re-emits whatever attributes
+	// were active when it broke.  Default value is false.
+	HandleANSI bool
+
+	// WidthFunc measures the display width of a chunk of text.  It
+	// governs every width comparison Wrap makes: the width available
+	// for indents and the placeholder, and the fit test applied to
+	// each chunk.  The default measures a rune as one column, which
+	// is wrong for East-Asian "wide" characters and combining marks.
+	// Callers wrapping CJK-heavy text should pass StringWidth (or
+	// their own func) via the WidthMeasure option.  Default value is
+	// a rune-counting func.
+	WidthFunc func(string) int
+
+	// If true, Wrap splits text on newlines before tokenizing and
+	// wraps each paragraph independently, so blank lines in the
+	// input are preserved as blank lines in the output instead of
+	// being collapsed into the surrounding paragraph.  Default value
+	// is false.
+	PreserveNewlines bool
+
+	// If true (and PreserveNewlines is also true), the leading
+	// whitespace of each input line is used as an implicit
+	// SubsequentIndent for the continuation lines produced by
+	// wrapping that paragraph, prepended to any configured
+	// SubsequentIndent.  Default value is false.
+	PreserveIndentation bool
+
+	// Alignment governs how Fill pads or justifies lines to Width.
+	// Default value is AlignLeft, which matches Fill's prior
+	// behavior of leaving lines as Wrap produced them.
+	Alignment Alignment
+
+	// If true, splitting a chunk too long to fit on its own line (see
+	// BreakLongWords) cuts only at extended grapheme cluster
+	// boundaries, so combining marks, ZWJ emoji sequences, and flag
+	// pairs are never split across lines. Default value is true;
+	// callers wrapping text known to be pure ASCII can set this to
+	// false to skip the cluster scan.
+	GraphemeAware bool
+
 	// These values define whitespace used in the wrapping functions.
 	// While they can theoretically be modified to, for instance, adapt
 	// the TexWrapper to a non-Latin character set, I have not tested
@@ -107,6 +151,14 @@ type TextWrapper struct {
 	// ConsWhitespaceRe is used by Shorten to replace consecutive
 	// whitespace characters with a single space
 	ConsWhitespaceRe *regexp.Regexp
+	// AnsiEscapeRe matches a single ANSI CSI or OSC escape sequence.
+	// It is used to tokenize escape sequences as their own chunks,
+	// and to recognize them as zero-width, if HandleANSI is true.
+	AnsiEscapeRe *regexp.Regexp
+	// LeadWhitespaceRe matches the leading whitespace of a line.  It
+	// is used to derive the implicit indent of a paragraph if
+	// PreserveIndentation is true.
+	LeadWhitespaceRe *regexp.Regexp
 }
 
 // NewTextWrapper returns a TextWrapper struct. Each field receives a
@@ -130,6 +182,8 @@ func NewTextWrapper(opts ...option) TextWrapper {
 		BreakOnHyphens:     true,
 		MaxLines:           0,
 		Placeholder:        " [...]",
+		WidthFunc:          func(s string) int { return len([]rune(s)) },
+		GraphemeAware:      true,
 	}
 
 	for _, opt := range opts {
@@ -145,11 +199,23 @@ func NewTextWrapper(opts ...option) TextWrapper {
 	t.WhitespaceRe = regexp.MustCompile("[" + t.OtherWhitespace + "]")
 	t.SentenceEndingRe = regexp.MustCompile("([^" + t.Whitespace + "]" +
 		"[.!?]['\"]?) [ ]*")
-	t.ChunksHyphenRe = regexp.MustCompile("(\u2014|[^" + t.Whitespace +
-		"]+-|" + "[^" + t.Whitespace + "]+|[" + t.Whitespace + "]+)")
-	t.ChunksNoHyphenRe = regexp.MustCompile("(\u2014|[^" + t.Whitespace +
-		"]+|" + "[" + t.Whitespace + "]+)")
+	t.AnsiEscapeRe = regexp.MustCompile(ansiEscapePattern)
+
+	// when HandleANSI is true, escape bytes are excluded from the
+	// "non-whitespace word" class so a word chunk stops before an
+	// escape sequence instead of swallowing it, letting the ansi
+	// alternative (tried first) match it as its own atom
+	chunkPrefix, nonSpace := "", "[^"+t.Whitespace+"]"
+	if t.HandleANSI {
+		chunkPrefix = ansiEscapePattern + "|"
+		nonSpace = "[^" + t.Whitespace + "\x1b]"
+	}
+	t.ChunksHyphenRe = regexp.MustCompile("(" + chunkPrefix + "\u2014|" +
+		nonSpace + "+-|" + nonSpace + "+|[" + t.Whitespace + "]+)")
+	t.ChunksNoHyphenRe = regexp.MustCompile("(" + chunkPrefix + "\u2014|" +
+		nonSpace + "+|[" + t.Whitespace + "]+)")
 	t.ConsWhitespaceRe = regexp.MustCompile("[" + t.Whitespace + "]+")
+	t.LeadWhitespaceRe = regexp.MustCompile("^[" + t.Whitespace + "]*")
 
 	return t
 }
@@ -162,10 +228,45 @@ func (t *TextWrapper) lStrip(s string) string {
 	return strings.TrimLeft(s, t.Whitespace)
 }
 
+// splitByWidth splits c at the last rune whose inclusion keeps the
+// measured width of the prefix within width, as measured by widthFunc.
+// At least one rune is always placed in the prefix, even if it alone
+// exceeds width, so that Wrap always makes progress on an over-long
+// chunk.
+func splitByWidth(c []rune, width int, widthFunc func(string) int) ([]rune, []rune) {
+	for n := 1; n <= len(c); n++ {
+		if widthFunc(string(c[:n])) > width {
+			if n == 1 {
+				return c[:1], c[1:]
+			}
+			return c[:n-1], c[n-1:]
+		}
+	}
+
+	return c, nil
+}
+
 // Wrap splits text into lines of specified length.  The TextWrapper
 // object contains fields that can be modified to control Wrap's
 // behavior.  See TextWrapper for descriptions of the fields.
 func (t *TextWrapper) Wrap(text string) []string {
+	lines, _ := t.wrap(text)
+	return lines
+}
+
+// wrap is Wrap's implementation.  Alongside the wrapped lines, it
+// returns a parallel slice reporting whether each line contains a
+// break-long-word split--either ending in the first half of a chunk
+// BreakLongWords forced apart, or beginning with the second half--so
+// alignLine can skip those lines for AlignJustify without having to
+// detect the split itself from the already-joined line.
+func (t *TextWrapper) wrap(text string) ([]string, []bool) {
+	// if PreserveNewlines is true, each paragraph is split out and
+	// wrapped independently rather than being wrapped as one blob
+	if t.PreserveNewlines {
+		return t.wrapParagraphs(text)
+	}
+
 	// First, Wrap checks if the values of TextWrapper's fields
 	// make it impossible to wrap the text.  This can occur if:
 	// (1) the line width is less than 1;
@@ -178,17 +279,26 @@ func (t *TextWrapper) Wrap(text string) []string {
 	}
 
 	// (3) MaxLines is positive, but the last line is not wide enough
-	//     to hold both the indent and the placeholder.
+	//     to hold both the indent and the placeholder; or
 	if t.MaxLines > 0 {
 		indent := t.SubsequentIndent
 		if t.MaxLines == 1 {
 			indent = t.InitialIndent
 		}
 
-		if len(indent)+len(t.lStrip(t.Placeholder)) > t.Width {
+		if t.WidthFunc(indent)+t.WidthFunc(t.lStrip(t.Placeholder)) > t.Width {
 			panic("Placeholder is too wide to fit on indented line.")
 		}
 	}
+
+	// (4) InitialIndent or SubsequentIndent is by itself at least as
+	//     wide as Width, leaving no room for even a single character
+	//     of text on the line (without this check, the main loop
+	//     below can never place a chunk and never advances).
+	if t.WidthFunc(t.InitialIndent) >= t.Width ||
+		t.WidthFunc(t.SubsequentIndent) >= t.Width {
+		panic("InitialIndent or SubsequentIndent leaves no room for any text.")
+	}
 	// If one of these conditions is met, Wrap panics instead  of
 	// restoring the default values because it is difficult to infer
 	// the user's intent and simpler to assume that a mistake occured.
@@ -220,7 +330,15 @@ func (t *TextWrapper) Wrap(text string) []string {
 
 	// iterates through lines
 	var lines []string
+	var splits []bool
+	var ansiState []string
+	var carrySplit bool
 	for i := 0; i < len(chunks); i++ {
+		lineSplit := carrySplit
+		carrySplit = false
+		// snapshots the attributes active at the start of this line,
+		// so they can be re-applied if the line before it was broken
+		lineState := append([]string(nil), ansiState...)
 		// drops leading whitespace if DropWhitespace is true
 		if len(lines) > 0 && t.DropWhitespace &&
 			t.strip(chunks[i]) == "" {
@@ -236,9 +354,9 @@ func (t *TextWrapper) Wrap(text string) []string {
 		}
 
 		// sets line width to allow room for indent and placeholder
-		width := t.Width - len([]rune(indent))
+		width := t.Width - t.WidthFunc(indent)
 		if t.MaxLines > 0 && len(lines) == t.MaxLines-1 {
-			width -= len(t.Placeholder)
+			width -= t.WidthFunc(t.Placeholder)
 		}
 
 		// appends chunks to current line until the next chunk would
@@ -246,41 +364,52 @@ func (t *TextWrapper) Wrap(text string) []string {
 		var curLen int
 		var curLine []string
 		for ; i < len(chunks); i++ {
-			if curLen+len([]rune(chunks[i])) >= width {
+			// a chunk that exactly fills the remaining width still
+			// fits; only a chunk that would overflow it breaks the
+			// line (an off-by-one here stalls Wrap forever on a
+			// chunk exactly as wide as an indented continuation line)
+			if curLen+t.chunkWidth(chunks[i]) > width {
 				i--
 				break
 			}
 			curLine = append(curLine, chunks[i])
-			curLen += len([]rune(chunks[i]))
+			curLen += t.chunkWidth(chunks[i])
+			if t.HandleANSI {
+				ansiState = updateANSIState(ansiState, chunks[i])
+			}
 		}
 
 		// peeks ahead to check if next chunk will need to be split
 		// or placed on its own line
-		if i+1 < len(chunks) && len([]rune(chunks[i+1])) > width {
+		if i+1 < len(chunks) && t.chunkWidth(chunks[i+1]) > width {
 			// if BreakLongWords is true, appends as much of the
 			// chunk as possible to the current line, and leaves any
 			// remainder for the next line
 			if t.BreakLongWords {
-				c := []rune(chunks[i+1])
-				spaceLeft := 1
-				if width >= 1 {
-					spaceLeft = width - curLen
+				spaceLeft := width - curLen
+				if spaceLeft < 1 {
+					spaceLeft = 1
 				}
-				curLine = append(curLine, string(c[:spaceLeft]))
-				curLen += spaceLeft
-				chunks[i+1] = string(c[spaceLeft:])
+				head, tail := t.splitChunk(chunks[i+1], spaceLeft)
+				curLine = append(curLine, head)
+				curLen += t.WidthFunc(head)
+				chunks[i+1] = tail
+				lineSplit, carrySplit = true, true
 				// or, if current line is empty, the chunk is appended
 			} else if curLen == 0 && len(lines) != t.MaxLines-1 {
 				i++
 				curLine = append(curLine, chunks[i])
-				curLen += len([]rune(chunks[i]))
+				curLen += t.chunkWidth(chunks[i])
+				if t.HandleANSI {
+					ansiState = updateANSIState(ansiState, chunks[i])
+				}
 			}
 		}
 
 		// if DropWhitespace is true, drops any trailing whitespace
 		if last := len(curLine) - 1; t.DropWhitespace &&
 			curLen > 0 && t.strip(curLine[last]) == "" {
-			curLen -= len([]rune(curLine[last]))
+			curLen -= t.chunkWidth(curLine[last])
 			curLine = curLine[:last]
 		}
 
@@ -295,22 +424,33 @@ func (t *TextWrapper) Wrap(text string) []string {
 			} else {
 				curLine = append(curLine, t.Placeholder)
 			}
-			lines = append(lines, indent+strings.Join(curLine, ""))
+			lines = append(lines, indent+t.ansiLine(lineState, ansiState, curLine))
+			splits = append(splits, lineSplit)
 			break
 			// or, if the current line is not empty, applies any indent
 			// and appends the current line to lines
 		} else if curLen > 0 {
-			lines = append(lines, indent+strings.Join(curLine, ""))
+			lines = append(lines, indent+t.ansiLine(lineState, ansiState, curLine))
+			splits = append(splits, lineSplit)
 		}
 	}
 
-	return lines
+	return lines, splits
 }
 
 // Fill wraps the text and returns a single string consisting of
-// the newline-separated lines.  The TextWrapper object contains
-// fields that can be modified to control Wrap's behavior.  See
-// TextWrapper for descriptions of the fields.
+// the newline-separated lines, aligned according to t.Alignment.
+// The TextWrapper object contains fields that can be modified to
+// control Wrap's behavior.  See TextWrapper for descriptions of the
+// fields.
 func (t *TextWrapper) Fill(text string) string {
-	return strings.Join(t.Wrap(text), t.Newline)
+	lines, splits := t.wrap(text)
+	return t.joinAligned(lines, splits, t.Alignment)
+}
+
+// FillAligned is like Fill, but aligns the wrapped lines according
+// to align instead of t.Alignment.
+func (t *TextWrapper) FillAligned(text string, align Alignment) string {
+	lines, splits := t.wrap(text)
+	return t.joinAligned(lines, splits, align)
 }
@@ -0,0 +1,84 @@
+package textwrap
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestWrapExactWidthChunk is a regression test for a hang: the line-break
+// test in Wrap's main loop once compared curLen+chunkWidth >= width instead
+// of > width, so a chunk that exactly filled the remaining width on a line
+// was pushed back onto the next line every time, making no progress and
+// looping forever instead of ever placing it.
+func TestWrapExactWidthChunk(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		opts []option
+		want []string
+	}{
+		{
+			name: "single chunk exactly as wide as the line",
+			text: "0123456789",
+			opts: []option{Width(10), BreakLongWords(false)},
+			want: []string{"0123456789"},
+		},
+		{
+			name: "continuation chunk exactly as wide as the indented line",
+			text: "0123456789 01234567",
+			opts: []option{Width(10), BreakLongWords(false), SubsequentIndent("  ")},
+			want: []string{"0123456789", "  01234567"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			done := make(chan []string, 1)
+			go func() {
+				done <- Wrap(c.text, c.opts...)
+			}()
+
+			select {
+			case got := <-done:
+				if !reflect.DeepEqual(got, c.want) {
+					t.Errorf("Wrap(%q) = %q, want %q", c.text, got, c.want)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("Wrap(%q) did not return within 1s; likely stuck on an exact-width chunk", c.text)
+			}
+		})
+	}
+}
+
+// TestWrapIndentWiderThanWidthPanics is a regression test for a hang:
+// once an indent wider than Width drove the line width in Wrap's main
+// loop negative, every chunk (eventually even the empty string left
+// behind by repeated splitChunk calls) still "didn't fit", so the
+// BreakLongWords retry path spun on the same chunk forever instead of
+// ever making progress. PreserveIndentation makes this reachable from
+// ordinary input, since it derives SubsequentIndent from whatever
+// leading whitespace a paragraph happens to have. Wrap now panics
+// up front instead, the same way it already does for an
+// impossibly-narrow MaxLines/Placeholder combination.
+func TestWrapIndentWiderThanWidthPanics(t *testing.T) {
+	text := "            one two three four five six seven eight nine ten"
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Wrap(%q) did not panic", text)
+			}
+		}()
+		Wrap(text, Width(8), PreserveNewlines(true),
+			PreserveIndentation(true), DropWhitespace(false))
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Wrap(%q) did not return within 1s; likely stuck on an over-wide indent", text)
+	}
+}
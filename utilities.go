@@ -2,6 +2,11 @@ package textwrap
 
 import "strings"
 
+// defaultWrapper supplies the whitespace, newline, and regexp
+// conventions used by Dedent, Indent, and Reindent, none of which
+// accept options of their own.
+var defaultWrapper = NewTextWrapper()
+
 // Wrap is a convenience function corresponding to TextWrapper.Wrap.
 // It accepts all of the same options as a TextWrapper.  As each 
 // call creates a new TextWrapper, programs that need to perform the
@@ -29,7 +34,7 @@ func Shorten(text string, opts ...option) string {
     t := NewTextWrapper(opts...)
     t.MaxLines = 1
 
-    text = ConsWhitespaceRe.ReplaceAllString(text, Space)
+    text = defaultWrapper.ConsWhitespaceRe.ReplaceAllString(text, defaultWrapper.Space)
 
     return t.Fill(text)
 }
@@ -38,15 +43,15 @@ func Shorten(text string, opts ...option) string {
 // by all lines--from each line of text.  Lines consisting entirely
 // of whitespace are ignored.
 func Dedent(text string) string {
-    lines := strings.Split(text, Newline)
+    lines := strings.Split(text, defaultWrapper.Newline)
     var indent string
 
     start := true
     for i, line := range lines {
-        if strip(line) == "" {
+        if defaultWrapper.strip(line) == "" {
             lines[i] = ""
         } else if start {
-            indent, start = LeadWhitespaceRe.FindString(line), false
+            indent, start = defaultWrapper.LeadWhitespaceRe.FindString(line), false
         } else if len(indent) != 0 {
             s, t := []rune(indent), []rune(line)
             var j int
@@ -62,16 +67,16 @@ func Dedent(text string) string {
         lines[i] = strings.TrimPrefix(lines[i], indent)
     }
 
-    return strings.Join(lines, Newline)
+    return strings.Join(lines, defaultWrapper.Newline)
 }
 
 // Indent prepends pref to lines within text.  Lines consisting only
 // of whitespace are ignored.  If pred is nil, each line is indented;
 // otherwise, only lines for which pred(line) == true are indented.
 func Indent(text, pref string, pred func(string) bool) string {
-    lines := strings.Split(text, Newline)
+    lines := strings.Split(text, defaultWrapper.Newline)
     for i, line := range lines {
-        if strip(line) == "" {
+        if defaultWrapper.strip(line) == "" {
             continue
         }
 
@@ -80,5 +85,14 @@ func Indent(text, pref string, pred func(string) bool) string {
         }
     }
 
-    return strings.Join(lines, Newline)
+    return strings.Join(lines, defaultWrapper.Newline)
+}
+
+// Reindent dedents text to zero common indent, as Dedent does, and
+// then prepends newIndent to every non-blank line.  It's useful for
+// re-indenting a heredoc-style string literal to match the
+// indentation of generated code or an error message it's embedded
+// in.
+func Reindent(text, newIndent string) string {
+    return Indent(Dedent(text), newIndent, nil)
 }
@@ -0,0 +1,93 @@
+package textwrap
+
+import "testing"
+
+func TestDedent(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "mixed tabs and spaces",
+			in:   "\t  foo\n\t    bar\n",
+			want: "foo\n  bar\n",
+		},
+		{
+			name: "common spaces indent",
+			in:   "  foo\n  bar\n    baz\n",
+			want: "foo\nbar\n  baz\n",
+		},
+		{
+			name: "blank lines don't affect the common indent",
+			in:   "  foo\n\n  bar\n",
+			want: "foo\n\nbar\n",
+		},
+		{
+			name: "all blank lines",
+			in:   "\n  \n\t\n",
+			want: "\n\n\n",
+		},
+		{
+			name: "single line",
+			in:   "    foo",
+			want: "foo",
+		},
+		{
+			name: "no common indent",
+			in:   "foo\n  bar\n",
+			want: "foo\n  bar\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Dedent(c.in); got != c.want {
+				t.Errorf("Dedent(%q) = %q, want %q", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestReindent(t *testing.T) {
+	cases := []struct {
+		name      string
+		in        string
+		newIndent string
+		want      string
+	}{
+		{
+			name:      "re-indents a dedented block",
+			in:        "  foo\n  bar\n",
+			newIndent: "\t\t",
+			want:      "\t\tfoo\n\t\tbar\n",
+		},
+		{
+			name:      "leaves blank lines alone",
+			in:        "  foo\n\n  bar\n",
+			newIndent: "> ",
+			want:      "> foo\n\n> bar\n",
+		},
+		{
+			name:      "single line",
+			in:        "    foo",
+			newIndent: "> ",
+			want:      "> foo",
+		},
+		{
+			name:      "all blank lines",
+			in:        "\n  \n",
+			newIndent: "> ",
+			want:      "\n\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Reindent(c.in, c.newIndent); got != c.want {
+				t.Errorf("Reindent(%q, %q) = %q, want %q",
+					c.in, c.newIndent, got, c.want)
+			}
+		})
+	}
+}
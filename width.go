@@ -0,0 +1,79 @@
+package textwrap
+
+import "unicode"
+
+// RuneWidth returns the number of terminal columns occupied by r, per
+// Unicode Standard Annex #11 (East Asian Width).  Combining marks and
+// other zero-width characters return 0, East Asian Wide and
+// Fullwidth characters return 2, and everything else (including
+// East Asian Ambiguous, which most terminals render narrow) returns
+// 1.
+func RuneWidth(r rune) int {
+	switch {
+	case isZeroWidth(r):
+		return 0
+	case isEastAsianWide(r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// StringWidth returns the sum of RuneWidth over each rune in s.  It
+// can be passed to the WidthMeasure option so that Wrap accounts for
+// East Asian wide characters and combining marks when computing line
+// width, instead of the default one-column-per-rune measurement.
+func StringWidth(s string) int {
+	var width int
+	for _, r := range s {
+		width += RuneWidth(r)
+	}
+
+	return width
+}
+
+// isZeroWidth reports whether r is a combining mark, joiner, or
+// other character that occupies no terminal column of its own.
+func isZeroWidth(r rune) bool {
+	switch {
+	case r == 0, r == '\u200b', r == '\u200c', r == '\u200d':
+		// NUL, zero width space, ZWNJ, ZWJ
+		return true
+	case r >= '\ufe00' && r <= '\ufe0f':
+		// variation selectors
+		return true
+	case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+		// nonspacing and enclosing combining marks
+		return true
+	}
+
+	return false
+}
+
+// isEastAsianWide reports whether r falls in one of the East Asian
+// Wide (W) or Fullwidth (F) ranges defined by UAX #11.  The ranges
+// below cover the common CJK, Hangul, and emoji blocks; characters
+// outside them are treated as narrow, matching the common terminal
+// convention for East Asian Ambiguous characters.
+func isEastAsianWide(r rune) bool {
+	switch {
+	case r >= 0x1100 && r <= 0x115F, // Hangul Jamo
+		r == 0x2329, r == 0x232A,
+		r >= 0x2E80 && r <= 0x303E, // CJK Radicals .. CJK punctuation
+		r >= 0x3041 && r <= 0x33FF, // Hiragana .. CJK Compatibility
+		r >= 0x3400 && r <= 0x4DBF, // CJK Unified Ideographs Ext A
+		r >= 0x4E00 && r <= 0x9FFF, // CJK Unified Ideographs
+		r >= 0xA000 && r <= 0xA4CF, // Yi Syllables and Radicals
+		r >= 0xAC00 && r <= 0xD7A3, // Hangul Syllables
+		r >= 0xF900 && r <= 0xFAFF, // CJK Compatibility Ideographs
+		r >= 0xFE30 && r <= 0xFE4F, // CJK Compatibility Forms
+		r >= 0xFF00 && r <= 0xFF60, // Fullwidth Forms
+		r >= 0xFFE0 && r <= 0xFFE6,
+		r >= 0x1F300 && r <= 0x1F64F, // emoji & pictographs
+		r >= 0x1F900 && r <= 0x1F9FF,
+		r >= 0x20000 && r <= 0x3FFFD: // CJK Unified Ideographs Ext B..
+		return true
+	}
+
+	return false
+}
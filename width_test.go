@@ -0,0 +1,49 @@
+package textwrap
+
+import "testing"
+
+func TestRuneWidth(t *testing.T) {
+	cases := []struct {
+		name string
+		r    rune
+		want int
+	}{
+		{"ASCII letter", 'a', 1},
+		{"combining acute accent", '́', 0},
+		{"zero width joiner", '‍', 0},
+		{"variation selector", '️', 0},
+		{"CJK ideograph", '中', 2},
+		{"Hangul syllable", '한', 2},
+		{"fullwidth latin", 'Ａ', 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := RuneWidth(c.r); got != c.want {
+				t.Errorf("RuneWidth(%q) = %d, want %d", c.r, got, c.want)
+			}
+		})
+	}
+}
+
+func TestStringWidth(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ASCII", "hello", 5},
+		{"base plus combining mark counts as one column", "é", 1},
+		{"CJK text", "中文", 4},
+		{"mixed ASCII and CJK", "a中b", 4},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := StringWidth(c.s); got != c.want {
+				t.Errorf("StringWidth(%q) = %d, want %d", c.s, got, c.want)
+			}
+		})
+	}
+}
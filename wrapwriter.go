@@ -0,0 +1,272 @@
+package textwrap
+
+import (
+	"io"
+	"strings"
+)
+
+// WrapWriter wraps text as it is written, emitting each completed
+// line to an underlying io.Writer instead of requiring the full
+// input up front the way TextWrapper.Wrap does.  This lets callers
+// pipe large or unbounded text--log tailing, generated output--through
+// wrapping without materializing the whole string.
+//
+// WrapWriter applies the same Width, indent, MaxLines, Placeholder,
+// BreakLongWords, and BreakOnHyphens rules as Wrap.  It does not
+// support HandleANSI, PreserveNewlines, FixSentenceEndings, or
+// Alignment; NewWrapWriter panics if any of those are set, since a
+// streaming writer can't apply them correctly without seeing text
+// that hasn't arrived yet.
+type WrapWriter struct {
+	t TextWrapper
+	w io.Writer
+
+	// pending holds raw, already-expanded-and-replaced input that
+	// has not yet been tokenized into a confirmed chunk.  The final
+	// chunk of any tokenization pass is always held back in pending
+	// rather than fed to the line, since a later Write could still
+	// extend it.
+	pending string
+
+	curLine     []string
+	curLen      int
+	indent      string
+	width       int
+	atLineStart bool
+	lines       int
+	done        bool
+	err         error
+}
+
+// NewWrapWriter returns a WrapWriter that wraps bytes written to it
+// and writes the result, plus a trailing t.Newline per line, to w.
+// It accepts the same options as NewTextWrapper, and panics under
+// the same conditions as Wrap, plus if HandleANSI, PreserveNewlines,
+// FixSentenceEndings, or a non-default Alignment is set.
+func NewWrapWriter(w io.Writer, opts ...option) *WrapWriter {
+	t := NewTextWrapper(opts...)
+
+	if t.Width < 1 {
+		panic("Width must be at least 1.")
+	} else if t.ExpandTabs && t.TabSize < 0 {
+		panic("Tab size must be at least 0 to expand tabs.")
+	}
+	if t.MaxLines > 0 {
+		indent := t.SubsequentIndent
+		if t.MaxLines == 1 {
+			indent = t.InitialIndent
+		}
+		if t.WidthFunc(indent)+t.WidthFunc(t.lStrip(t.Placeholder)) > t.Width {
+			panic("Placeholder is too wide to fit on indented line.")
+		}
+	}
+	if t.WidthFunc(t.InitialIndent) >= t.Width ||
+		t.WidthFunc(t.SubsequentIndent) >= t.Width {
+		panic("InitialIndent or SubsequentIndent leaves no room for any text.")
+	}
+	if t.HandleANSI || t.PreserveNewlines || t.FixSentenceEndings || t.Alignment != AlignLeft {
+		panic("WrapWriter does not support HandleANSI, PreserveNewlines, " +
+			"FixSentenceEndings, or Alignment.")
+	}
+
+	ww := &WrapWriter{t: t, w: w}
+	ww.startLine()
+	return ww
+}
+
+// startLine resets the WrapWriter's accumulating state for a new
+// line, selecting its indent and the width remaining for chunks the
+// same way Wrap does at the top of its outer loop.
+func (ww *WrapWriter) startLine() {
+	if ww.lines == 0 {
+		ww.indent = ww.t.InitialIndent
+	} else {
+		ww.indent = ww.t.SubsequentIndent
+	}
+
+	ww.width = ww.t.Width - ww.t.WidthFunc(ww.indent)
+	if ww.t.MaxLines > 0 && ww.lines == ww.t.MaxLines-1 {
+		ww.width -= ww.t.WidthFunc(ww.t.Placeholder)
+	}
+
+	ww.curLine = nil
+	ww.curLen = 0
+	ww.atLineStart = true
+}
+
+// Write implements io.Writer.  p is expanded and whitespace-replaced
+// the same way Wrap treats its input, then appended to the pending
+// buffer, which is tokenized as far as it safely can be: every chunk
+// before the trailing non-whitespace run (or, if the buffer ends in
+// whitespace, before its final whitespace chunk) is fed to the current
+// line, since what is held back could still be extended by a future
+// Write.  Call Flush or Close to wrap and emit the held-back chunks
+// and the final, possibly partial line.
+func (ww *WrapWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if ww.err != nil {
+		return 0, ww.err
+	}
+	if ww.done {
+		return n, nil
+	}
+
+	s := string(p)
+	if ww.t.ExpandTabs {
+		s = strings.Replace(s, ww.t.Tab, strings.Repeat(ww.t.Space, ww.t.TabSize), -1)
+	}
+	if ww.t.ReplaceWhitespace {
+		s = ww.t.WhitespaceRe.ReplaceAllString(s, ww.t.Space)
+	}
+
+	ww.pending += s
+	ww.drain(false)
+
+	return n, ww.err
+}
+
+// Flush wraps and emits any buffered input, including a final
+// partial chunk and line, as though no more input were coming. It
+// does not close w.
+func (ww *WrapWriter) Flush() error {
+	if ww.err == nil && !ww.done {
+		ww.drain(true)
+	}
+
+	return ww.err
+}
+
+// Close flushes any buffered input.  It does not close w.
+func (ww *WrapWriter) Close() error {
+	return ww.Flush()
+}
+
+// drain tokenizes pending and feeds every chunk it can safely
+// consider final to the current line, withholding the trailing
+// contiguous non-whitespace run unless final is true, in which case
+// it also closes out whatever partial line remains.
+//
+// A single held-back chunk isn't enough when BreakOnHyphens is true:
+// ChunksHyphenRe's nonSpace+- alternative is greedy over the whole
+// run and backtracks to the last hyphen in it, so a hyphen arriving
+// in a later Write can retroactively change where an already-matched
+// chunk earlier in the same run should have ended.  Holding back the
+// entire run until whitespace (or final) confirms it's complete
+// avoids committing to a boundary that later input could undo.
+func (ww *WrapWriter) drain(final bool) {
+	re := ww.t.ChunksNoHyphenRe
+	if ww.t.BreakOnHyphens {
+		re = ww.t.ChunksHyphenRe
+	}
+
+	idx := re.FindAllStringIndex(ww.pending, -1)
+	n := len(idx)
+	if !final {
+		for n > 0 && ww.t.strip(ww.pending[idx[n-1][0]:idx[n-1][1]]) != "" {
+			n--
+		}
+		if n == len(idx) && n > 0 {
+			n--
+		}
+	}
+
+	for i := 0; i < n && !ww.done; i++ {
+		ww.feed(ww.pending[idx[i][0]:idx[i][1]])
+	}
+
+	if final {
+		ww.pending = ""
+		if !ww.done && ww.curLen > 0 {
+			ww.closeLine()
+		}
+	} else if n < len(idx) {
+		ww.pending = ww.pending[idx[n][0]:]
+	}
+}
+
+// feed adds a single confirmed chunk to the current line, closing
+// and starting lines exactly as the inner loop of Wrap does.
+func (ww *WrapWriter) feed(c string) {
+	if ww.done {
+		return
+	}
+
+	if ww.atLineStart {
+		if ww.t.DropWhitespace && ww.lines > 0 && ww.t.strip(c) == "" {
+			return
+		}
+		ww.atLineStart = false
+	}
+
+	cw := ww.t.chunkWidth(c)
+	if ww.curLen+cw <= ww.width {
+		ww.curLine = append(ww.curLine, c)
+		ww.curLen += cw
+		return
+	}
+
+	// c doesn't fit on the current line.  This mirrors Wrap's peek at
+	// the chunk following a closed line: a chunk that wouldn't fit on
+	// a fresh line either is split by BreakLongWords across the line
+	// being closed and the one after, or forced onto an empty line to
+	// guarantee progress; anything that would fit on a fresh line is
+	// simply deferred to the line that follows.
+	if cw > ww.width && ww.t.BreakLongWords {
+		spaceLeft := ww.width - ww.curLen
+		if spaceLeft < 1 {
+			spaceLeft = 1
+		}
+		head, tail := ww.t.splitChunk(c, spaceLeft)
+		ww.curLine = append(ww.curLine, head)
+		ww.curLen += ww.t.WidthFunc(head)
+		ww.closeLine()
+		if len(tail) > 0 {
+			ww.feed(tail)
+		}
+	} else if cw > ww.width && ww.curLen == 0 && ww.lines != ww.t.MaxLines-1 {
+		ww.curLine = append(ww.curLine, c)
+		ww.curLen += cw
+		ww.closeLine()
+	} else {
+		ww.closeLine()
+		ww.feed(c)
+	}
+}
+
+// closeLine drops curLine's trailing whitespace if DropWhitespace is
+// true, applies the Placeholder if this is the MaxLines-th line,
+// writes the line, and starts the next one.
+func (ww *WrapWriter) closeLine() {
+	if last := len(ww.curLine) - 1; ww.t.DropWhitespace &&
+		ww.curLen > 0 && ww.t.strip(ww.curLine[last]) == "" {
+		ww.curLen -= ww.t.chunkWidth(ww.curLine[last])
+		ww.curLine = ww.curLine[:last]
+	}
+
+	if ww.t.MaxLines > 0 && ww.lines == ww.t.MaxLines-1 {
+		if ww.curLen == 0 {
+			ww.curLine = append(ww.curLine, ww.t.lStrip(ww.t.Placeholder))
+		} else {
+			ww.curLine = append(ww.curLine, ww.t.Placeholder)
+		}
+		ww.writeLine()
+		ww.done = true
+		return
+	} else if ww.curLen > 0 {
+		ww.writeLine()
+		ww.lines++
+	}
+
+	ww.startLine()
+}
+
+// writeLine joins curLine onto its indent and writes it, followed by
+// t.Newline, to w.
+func (ww *WrapWriter) writeLine() {
+	if ww.err != nil {
+		return
+	}
+
+	line := ww.indent + strings.Join(ww.curLine, "") + ww.t.Newline
+	_, ww.err = io.WriteString(ww.w, line)
+}
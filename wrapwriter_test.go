@@ -0,0 +1,85 @@
+package textwrap
+
+import (
+	"strings"
+	"testing"
+)
+
+// writeInChunks writes s to ww one byte at a time, exercising the case
+// where a chunk straddles multiple Write calls.
+func writeInChunks(t *testing.T, ww *WrapWriter, s string) {
+	t.Helper()
+	for i := 0; i < len(s); i++ {
+		if _, err := ww.Write([]byte(s[i : i+1])); err != nil {
+			t.Fatalf("Write(%q) = %v", s[i:i+1], err)
+		}
+	}
+}
+
+func TestWrapWriterMatchesWrap(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		opts []option
+	}{
+		{"short words", "one two three four five", []option{Width(10)}},
+		{"a long word forces BreakLongWords", "supercalifragilisticexpialidocious word", []option{Width(10)}},
+		{"indents", "one two three four five", []option{Width(10), InitialIndent("> "), SubsequentIndent(".. ")}},
+		{"a hyphenated run split across many Write calls", "well-known-hyphenated-word", []option{Width(10)}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b strings.Builder
+			ww := NewWrapWriter(&b, c.opts...)
+			writeInChunks(t, ww, c.text)
+			if err := ww.Close(); err != nil {
+				t.Fatalf("Close() = %v", err)
+			}
+
+			want := strings.Join(Wrap(c.text, c.opts...), "\n") + "\n"
+			if got := b.String(); got != want {
+				t.Errorf("WrapWriter output = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWrapWriterMaxLines(t *testing.T) {
+	text := "one two three four five six seven eight"
+
+	var b strings.Builder
+	ww := NewWrapWriter(&b, Width(10), MaxLines(2))
+	writeInChunks(t, ww, text)
+	if err := ww.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	want := strings.Join(Wrap(text, Width(10), MaxLines(2)), "\n") + "\n"
+	if got := b.String(); got != want {
+		t.Errorf("WrapWriter output = %q, want %q", got, want)
+	}
+}
+
+func TestNewWrapWriterPanicsOnUnsupportedOptions(t *testing.T) {
+	cases := []struct {
+		name string
+		opts []option
+	}{
+		{"HandleANSI", []option{Width(10), HandleANSI(true)}},
+		{"PreserveNewlines", []option{Width(10), PreserveNewlines(true)}},
+		{"FixSentenceEndings", []option{Width(10), FixSentenceEndings(true)}},
+		{"Alignment", []option{Width(10), Align(AlignRight)}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewWrapWriter did not panic with %s set", c.name)
+				}
+			}()
+			NewWrapWriter(&strings.Builder{}, c.opts...)
+		})
+	}
+}